@@ -387,6 +387,28 @@ func (c *Config) initMaps() error {
 		groups[g.Name].Groups = childGroups
 	}
 
+	// Third pass: Service requirements
+	for _, s := range append(c.Services, c.ImportedServices...) {
+		sc, ok := svcs[s.Name]
+		if !ok {
+			continue
+		}
+		sc.RequiredServices = nil
+		for _, name := range sc.Requires {
+			required := findService(svcs, name)
+			if required == nil {
+				if _, skipped := servicesSkipped[name]; !skipped {
+					orphanNames[name] = struct{}{}
+				}
+				continue
+			}
+			sc.RequiredServices = append(sc.RequiredServices, required)
+			if hasRequirementCycle(sc, sc.RequiredServices) {
+				return errors.New("requirement cycle: " + sc.Name)
+			}
+		}
+	}
+
 	if len(orphanNames) > 0 {
 		var keys []string
 		for k := range orphanNames {
@@ -412,6 +434,30 @@ func hasChildCycle(parent *services.ServiceGroupConfig, children []*services.Ser
 	return false
 }
 
+func hasRequirementCycle(service *services.ServiceConfig, required []*services.ServiceConfig) bool {
+	for _, r := range required {
+		if service == r {
+			return true
+		}
+		if hasRequirementCycle(service, r.RequiredServices) {
+			return true
+		}
+	}
+	return false
+}
+
+func findService(svcs map[string]*services.ServiceConfig, name string) *services.ServiceConfig {
+	if s, ok := svcs[name]; ok {
+		return s
+	}
+	for _, s := range svcs {
+		if s.Matches(name) {
+			return s
+		}
+	}
+	return nil
+}
+
 func stringSliceIntersect(slices [][]string) []string {
 	var counts = make(map[string]int)
 	for _, s := range slices {
@@ -133,6 +133,53 @@ var group3 = services.ServiceGroupConfig{
 	ChildOrder: []string{"service3"},
 }
 
+var serviceDatabase = services.ServiceConfig{
+	Name: "database",
+	Backends: []*services.BackendConfig{
+		{
+			Type: "commandline",
+			Config: &commandline.Backend{
+				Commands: commandline.ServiceConfigCommands{
+					Launch: "launchDatabase",
+				},
+			},
+		},
+	},
+}
+
+var serviceQueue = services.ServiceConfig{
+	Name:             "queue",
+	Aliases:          []string{"mq"},
+	Requires:         []string{"database"},
+	RequiredServices: []*services.ServiceConfig{&serviceDatabase},
+	Backends: []*services.BackendConfig{
+		{
+			Type: "commandline",
+			Config: &commandline.Backend{
+				Commands: commandline.ServiceConfigCommands{
+					Launch: "launchQueue",
+				},
+			},
+		},
+	},
+}
+
+var serviceApi = services.ServiceConfig{
+	Name:             "api",
+	Requires:         []string{"database", "mq"},
+	RequiredServices: []*services.ServiceConfig{&serviceDatabase, &serviceQueue},
+	Backends: []*services.BackendConfig{
+		{
+			Type: "commandline",
+			Config: &commandline.Backend{
+				Commands: commandline.ServiceConfigCommands{
+					Launch: "launchApi",
+				},
+			},
+		},
+	},
+}
+
 var fileBasedTests = []struct {
 	name            string
 	inFile          string
@@ -218,6 +265,26 @@ var fileBasedTests = []struct {
 		inFile: "bad.json",
 		outErr: errors.New("could not parse config file (line 7, char 9): invalid character ':' after array element"),
 	},
+	{
+		name:   "Service requirements",
+		inFile: "requires.json",
+		outServiceMap: map[string]*services.ServiceConfig{
+			"database": &serviceDatabase,
+			"queue":    &serviceQueue,
+			"api":      &serviceApi,
+		},
+		outGroupMap: map[string]*services.ServiceGroupConfig{},
+	},
+	{
+		name:   "Requirement cycle",
+		inFile: "requirescycle.json",
+		outErr: errors.New("requirement cycle: service3"),
+	},
+	{
+		name:   "Missing requirement",
+		inFile: "requiresmissing.json",
+		outErr: errors.New("A service or group could not be found for the following names: missing"),
+	},
 	{
 		name:            "With telemetry",
 		inFile:          "telemetry.json",
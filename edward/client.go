@@ -47,6 +47,10 @@ type Client struct {
 	Backends map[string]string // Service overrides for backends
 }
 
+// requirementsPoolSize is the number of workers used for launching services
+// when the services involved declare requirements.
+const requirementsPoolSize = 3
+
 type TaskFollower interface {
 	Handle(update tracker.Task)
 	Done()
@@ -123,13 +127,17 @@ func (c *Client) startAndTrack(sgs []services.ServiceOrGroup, skipBuild bool, no
 	defer c.Follower.Done()
 
 	poolSize := 1
+	if services.HasRequirements(sgs) {
+		// Services that don't depend on one another can be launched in parallel
+		poolSize = requirementsPoolSize
+	}
 	if c.DisableConcurrentPhases {
 		poolSize = 0
 	}
 	p := worker.NewPool(poolSize)
 	p.Start()
 	var err error
-	err = services.DoForServices(sgs, task, func(s *services.ServiceConfig, overrides services.ContextOverride, task tracker.Task) error {
+	err = services.DoForServicesInLevels(sgs, task, false, func(s *services.ServiceConfig, overrides services.ContextOverride, task tracker.Task) error {
 		if skipBuild {
 			log.Println("skipping build phase")
 			err = instance.Launch(c.DirConfig, s, cfg, overrides, task, p)
@@ -151,6 +159,10 @@ func (c *Client) startAndTrack(sgs []services.ServiceOrGroup, skipBuild bool, no
 			return errors.WithMessage(err, "Error starting "+s.GetName()+": launch")
 		}
 		return nil
+	}, func() error {
+		// Required services must be running before their dependents are launched
+		p.Wait()
+		return errors.WithStack(p.Err())
 	})
 	if err != nil {
 		return errors.WithStack(err)
@@ -65,6 +65,14 @@ func (c *Client) restartOneOrMoreServices(serviceNames []string, skipBuild bool,
 	if err != nil {
 		return errors.WithStack(err)
 	}
+
+	// Required services that were not explicitly named will be started if they are not already running
+	var requested = make(map[*services.ServiceConfig]struct{})
+	for _, s := range services.Services(sgs) {
+		requested[s] = struct{}{}
+	}
+	sgs = services.WithRequirements(sgs)
+
 	if c.ServiceChecks != nil {
 		if err = c.ServiceChecks(sgs); err != nil {
 			return errors.WithStack(err)
@@ -96,7 +104,7 @@ func (c *Client) restartOneOrMoreServices(serviceNames []string, skipBuild bool,
 		launchPool.Stop()
 		_ = <-launchPool.Complete()
 	}()
-	err = services.DoForServices(sgs, task, func(service *services.ServiceConfig, overrides services.ContextOverride, task tracker.Task) error {
+	err = services.DoForServicesInLevels(sgs, task, false, func(service *services.ServiceConfig, overrides services.ContextOverride, task tracker.Task) error {
 		var err error
 		i, err := instance.Load(c.DirConfig, &processes.Processes{}, service, overrides)
 		if err != nil {
@@ -104,9 +112,11 @@ func (c *Client) restartOneOrMoreServices(serviceNames []string, skipBuild bool,
 		}
 		overrides = i.Overrides.Merge(overrides)
 
-		err = i.StopSync(cfg, overrides, task)
-		if err != nil {
-			return errors.WithStack(err)
+		if _, ok := requested[service]; ok {
+			err = i.StopSync(cfg, overrides, task)
+			if err != nil {
+				return errors.WithStack(err)
+			}
 		}
 
 		if !cfg.SkipBuild {
@@ -122,6 +132,6 @@ func (c *Client) restartOneOrMoreServices(serviceNames []string, skipBuild bool,
 		}
 
 		return nil
-	})
+	}, nil)
 	return errors.WithStack(err)
 }
@@ -28,6 +28,7 @@ func (c *Client) Start(names []string, skipBuild bool, noWatch bool, exclude []s
 			return errors.WithStack(err)
 		}
 	}
+	sgs = services.WithRequirements(sgs)
 	if c.ServiceChecks != nil {
 		err = c.ServiceChecks(sgs)
 		if err != nil {
@@ -50,9 +50,13 @@ func (c *Client) Stop(names []string, force bool, exclude []string, all bool) er
 		_ = <-p.Complete()
 	}()
 
-	err = services.DoForServices(sgs, task, func(s *services.ServiceConfig, overrides services.ContextOverride, task tracker.Task) error {
+	// Stop services before any services they require
+	err = services.DoForServicesInLevels(sgs, task, true, func(s *services.ServiceConfig, overrides services.ContextOverride, task tracker.Task) error {
 		_ = instance.Stop(c.DirConfig, s, cfg, overrides, task, p)
 		return nil
+	}, func() error {
+		p.Wait()
+		return nil
 	})
 	if err != nil {
 		return errors.WithStack(err)
@@ -16,6 +16,10 @@ import (
 	"github.com/yext/edward/worker"
 )
 
+// failureLogLines is the number of lines from the end of a service's log to be
+// shown when it fails to start
+const failureLogLines = 20
+
 // Launch launches this service
 func Launch(dirConfig *home.EdwardConfiguration, c *services.ServiceConfig, cfg services.OperationConfig, overrides services.ContextOverride, task tracker.Task, pool *worker.Pool) error {
 	if cfg.IsExcluded(c) {
@@ -109,6 +113,9 @@ func (c *Instance) StartAsync(cfg services.OperationConfig, task tracker.Task) e
 	if readingErr != nil {
 		startTask.SetState(tracker.TaskStateFailed, "Could not read log", readingErr.Error(), fmt.Sprint("Original error: ", err.Error()))
 	} else {
+		if len(log) > failureLogLines {
+			log = log[len(log)-failureLogLines:]
+		}
 		log = append(log, err.Error())
 		startTask.SetState(tracker.TaskStateFailed, log...)
 	}
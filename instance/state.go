@@ -16,20 +16,23 @@ import (
 type State string
 
 const (
-	StateStarting State = "STARTING"
-	StateRunning  State = "RUNNING"
-	StateStopped  State = "STOPPED"
-	StateDied     State = "DIED"
-	StateUnknown  State = "UNKNOWN"
+	StateStarting   State = "STARTING"
+	StateRunning    State = "RUNNING"
+	StateStopped    State = "STOPPED"
+	StateDied       State = "DIED"
+	StateRestarting State = "RESTARTING"
+	StateUnknown    State = "UNKNOWN"
 )
 
 type Status struct {
-	State       State                   `json:"status"`
-	Ports       []string                `json:"ports"` // Ports opened by this instance
-	StdoutLines int                     `json:"stdoutLines"`
-	StderrLines int                     `json:"stderrLines"`
-	StartTime   time.Time               `json:"startTime"`
-	MemoryInfo  *process.MemoryInfoStat `json:"memoryInfo,omitempty"`
+	State        State                   `json:"status"`
+	Ports        []string                `json:"ports"` // Ports opened by this instance
+	StdoutLines  int                     `json:"stdoutLines"`
+	StderrLines  int                     `json:"stderrLines"`
+	StartTime    time.Time               `json:"startTime"`
+	MemoryInfo   *process.MemoryInfoStat `json:"memoryInfo,omitempty"`
+	RestartCount int                     `json:"restartCount,omitempty"` // Number of times this instance was restarted by its restart policy
+	LastExitCode *int                    `json:"lastExitCode,omitempty"` // Exit code from the most recent exit of the service process
 }
 
 var store = make(map[string]Status)
@@ -112,6 +112,8 @@ func (r *Runner) Run(args []string) error {
 
 	err = r.startService()
 	if err != nil {
+		log.Printf("Service failed to start: %v\n", err)
+		r.updateServiceState(instance.StateDied)
 		return errors.WithStack(err)
 	}
 
@@ -123,22 +125,69 @@ func (r *Runner) Run(args []string) error {
 		defer closeWatchers()
 	}
 
-	r.commandWait.Wait()
+	for {
+		r.commandWait.Wait()
+
+		// Wait for shutdown.
+		// If the service stopped and an interrupt was not sent, do not set the "DIED" state.
+		select {
+		case <-r.shutdownChan:
+			r.updateServiceState(instance.StateStopped)
+			log.Printf("Service stopped\n")
+			return nil
+		default:
+		}
+
+		if !r.restartAfterExit() {
+			break
+		}
+	}
+
+	r.updateServiceState(instance.StateDied)
+	statusTick.Stop()
+	statusTick = nil
+	return nil
+}
+
+// restartAfterExit records the exit of the service process and restarts it if
+// required by the service's restart policy.
+// Returns false if the service was not restarted.
+func (r *Runner) restartAfterExit() bool {
+	exitCode := -1
+	if reporter, ok := r.backendRunner.(services.ExitReporter); ok {
+		if code, exited := reporter.ExitCode(); exited {
+			exitCode = code
+		}
+	}
+	r.status.LastExitCode = &exitCode
+
+	policy := r.Service.Restart
+	if !policy.ShouldRestart(exitCode, r.status.RestartCount) {
+		log.Printf("Service exited with code %d\n", exitCode)
+		return false
+	}
+
+	backoff := policy.GetBackoff(r.status.RestartCount)
+	log.Printf("Service exited with code %d, restarting in %v\n", exitCode, backoff)
+	r.updateServiceState(instance.StateRestarting)
 
-	// Wait for shutdown.
-	// If the service stopped and an interrupt was not sent, do not set the "DIED" state.
 	select {
 	case <-r.shutdownChan:
-		r.updateServiceState(instance.StateStopped)
-		log.Printf("Service stopped\n")
-		return nil
-	default:
-		r.updateServiceState(instance.StateDied)
-		statusTick.Stop()
-		statusTick = nil
+		return true
+	case <-time.After(backoff):
 	}
 
-	return nil
+	r.status.RestartCount++
+	r.commandWait.Add(1)
+	err := r.startService()
+	if err != nil {
+		log.Printf("Could not restart service: %v\n", err)
+		r.commandWait.Done()
+		return true
+	}
+	r.updateStatusDetail()
+	r.updateServiceState(instance.StateRunning)
+	return true
 }
 
 func (r *Runner) updateServiceState(newState instance.State) {
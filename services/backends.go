@@ -27,6 +27,13 @@ type Runner interface {
 	Wait()
 }
 
+// ExitReporter may be implemented by a Runner that can report how its process exited.
+type ExitReporter interface {
+	// ExitCode returns the exit code of the most recently started process.
+	// If the process has not exited, false will be returned.
+	ExitCode() (int, bool)
+}
+
 type BackendStatus struct {
 	Ports      []string
 	MemoryInfo *process.MemoryInfoStat
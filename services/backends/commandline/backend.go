@@ -2,6 +2,7 @@ package commandline
 
 import (
 	"encoding/json"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/yext/edward/services"
@@ -15,6 +16,9 @@ type Backend struct {
 
 	// Checks to perform to ensure that a service has started correctly
 	LaunchChecks *LaunchChecks `json:"launch_checks,omitempty"`
+
+	// Checks to perform periodically to ensure that a running service is still healthy
+	LivenessChecks *LivenessChecks `json:"liveness_checks,omitempty"`
 }
 
 func (c *Backend) UnmarshalJSON(data []byte) error {
@@ -32,20 +36,14 @@ func (c *Backend) UnmarshalJSON(data []byte) error {
 	}
 
 	if c.LaunchChecks != nil {
-		checkCount := 0
-		if len(c.LaunchChecks.LogText) > 0 {
-			checkCount++
-		}
-		if len(c.LaunchChecks.Ports) > 0 {
-			checkCount++
+		if err := c.LaunchChecks.validate(); err != nil {
+			return errors.Wrap(err, "invalid launch checks")
 		}
-		if c.LaunchChecks.Wait != 0 {
-			checkCount++
-		}
-		if checkCount > 1 {
-			return errors.New("cannot specify multiple launch check types for one service")
+	}
+	if c.LivenessChecks != nil {
+		if err := validateChecks(c.LivenessChecks.HTTP, c.LivenessChecks.TCP, c.LivenessChecks.Commands); err != nil {
+			return errors.Wrap(err, "invalid liveness checks")
 		}
-
 	}
 	return nil
 
@@ -87,6 +85,75 @@ type LaunchChecks struct {
 	Ports []int `json:"ports,omitempty"`
 	// Wait for a specified amount of time (in ms) before calling the service started if still running.
 	Wait int64 `json:"wait,omitempty"`
+	// HTTP endpoints that are expected to respond successfully when this service starts.
+	HTTP []*HTTPCheck `json:"http,omitempty"`
+	// TCP addresses that are expected to accept connections when this service starts.
+	TCP []*TCPCheck `json:"tcp,omitempty"`
+	// Commands that are expected to exit successfully when this service starts.
+	Commands []*CommandCheck `json:"commands,omitempty"`
+	// Whether "all" (the default) or "any" of the above checks must pass for the service to be started.
+	Require string `json:"require,omitempty"`
+}
+
+func (l *LaunchChecks) validate() error {
+	switch l.Require {
+	case "", RequireAll, RequireAny:
+	default:
+		return errors.Errorf("unknown require value %q, expected %q or %q", l.Require, RequireAll, RequireAny)
+	}
+	return errors.WithStack(validateChecks(l.HTTP, l.TCP, l.Commands))
+}
+
+// LivenessChecks defines checks that are performed periodically while a service is running.
+// If the checks fail repeatedly, the service will be stopped.
+type LivenessChecks struct {
+	// One or more specific ports that are expected to be open while this service is running.
+	Ports []int `json:"ports,omitempty"`
+	// HTTP endpoints that are expected to respond successfully while this service is running.
+	HTTP []*HTTPCheck `json:"http,omitempty"`
+	// TCP addresses that are expected to accept connections while this service is running.
+	TCP []*TCPCheck `json:"tcp,omitempty"`
+	// Commands that are expected to exit successfully while this service is running.
+	Commands []*CommandCheck `json:"commands,omitempty"`
+	// Time between each round of checks. Defaults to 10s.
+	Period *services.Duration `json:"period,omitempty"`
+	// Number of consecutive failed rounds after which the service will be stopped. Defaults to 3.
+	FailureThreshold int `json:"failure_threshold,omitempty"`
+}
+
+// GetPeriod returns the time between each round of liveness checks
+func (l *LivenessChecks) GetPeriod() time.Duration {
+	if l.Period == nil {
+		return 10 * time.Second
+	}
+	return l.Period.Duration
+}
+
+// GetFailureThreshold returns the number of consecutive failures after which a service will be stopped
+func (l *LivenessChecks) GetFailureThreshold() int {
+	if l.FailureThreshold <= 0 {
+		return 3
+	}
+	return l.FailureThreshold
+}
+
+func validateChecks(httpChecks []*HTTPCheck, tcpChecks []*TCPCheck, commandChecks []*CommandCheck) error {
+	for _, c := range httpChecks {
+		if err := c.validate(); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	for _, c := range tcpChecks {
+		if err := c.validate(); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	for _, c := range commandChecks {
+		if err := c.validate(); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	return nil
 }
 
 // ServiceConfigCommands define the commands for building, launching and stopping a service
@@ -26,11 +26,14 @@ type buildandrun struct {
 
 	launchConditionMet chan struct{}
 
+	exitCode int
+
 	mtx sync.Mutex
 }
 
 var _ services.Builder = &buildandrun{}
 var _ services.Runner = &buildandrun{}
+var _ services.ExitReporter = &buildandrun{}
 
 func (b *buildandrun) Build(workingDir string, getenv func(string) string, output io.Writer) error {
 	cmd, err := commandline.ConstructCommand(workingDir, b.Service.Path, b.Backend.Commands.Build, getenv)
@@ -47,7 +50,7 @@ func (b *buildandrun) Start(standardLog io.Writer, errorLog io.Writer) error {
 	b.mtx.Lock()
 	defer b.mtx.Unlock()
 
-	if b.cmd != nil {
+	if b.cmd != nil && !b.exited() {
 		return errors.New("service already started")
 	}
 
@@ -78,6 +81,7 @@ func (b *buildandrun) Start(standardLog io.Writer, errorLog io.Writer) error {
 		if err != nil {
 			fmt.Fprint(errorLog, err)
 		}
+		b.exitCode = b.cmd.ProcessState.ExitCode()
 	}()
 
 	err = <-started
@@ -86,7 +90,7 @@ func (b *buildandrun) Start(standardLog io.Writer, errorLog io.Writer) error {
 		return errors.WithStack(err)
 	}
 
-	var live = make(chan error)
+	var live = make(chan error, 1)
 	go func() {
 		err = b.waitUntilLive()
 		if err != nil {
@@ -95,11 +99,25 @@ func (b *buildandrun) Start(standardLog io.Writer, errorLog io.Writer) error {
 		close(live)
 	}()
 
+	launchTimeout := b.Service.GetLaunchTimeout()
+	timer := time.NewTimer(launchTimeout)
+	defer timer.Stop()
+
 	select {
 	case <-b.done:
 		return errors.New("process exited")
 	case e := <-live:
+		if e == nil && b.Backend.LivenessChecks != nil {
+			go b.monitorLiveness(b.done, errorLog)
+		}
 		return errors.WithStack(e)
+	case <-timer.C:
+		err := errors.Errorf("launch timed out after %v", launchTimeout)
+		fmt.Fprintln(errorLog, err)
+		if stopErr := b.stopGroup(); stopErr != nil {
+			return errors.WithMessage(stopErr, err.Error())
+		}
+		return err
 	}
 }
 
@@ -156,6 +174,23 @@ func (b *buildandrun) Wait() {
 	<-b.done
 }
 
+// ExitCode returns the exit code of the most recently started process.
+func (b *buildandrun) ExitCode() (int, bool) {
+	if b.done == nil || !b.exited() {
+		return 0, false
+	}
+	return b.exitCode, true
+}
+
+func (b *buildandrun) exited() bool {
+	select {
+	case <-b.done:
+		return true
+	default:
+		return false
+	}
+}
+
 func (b *buildandrun) waitForCompletionWithTimeout(timeout time.Duration) bool {
 	var completed = make(chan struct{})
 	timer := time.NewTimer(timeout)
@@ -195,29 +230,36 @@ func (b *buildandrun) Stop(workingDir string, getenv func(string) string) ([]byt
 		}
 	}
 
-	err := InterruptGroup(b.cmd.Process.Pid, b.Service)
+	err := b.stopGroup()
 	if err != nil {
 		return out, errors.WithStack(err)
 	}
+	return out, nil
+}
+
+// stopGroup interrupts the process group for this service, and kills it if
+// it has not stopped within the termination timeout.
+func (b *buildandrun) stopGroup() error {
+	err := InterruptGroup(b.cmd.Process.Pid, b.Service)
+	if err != nil {
+		return errors.WithStack(err)
+	}
 
 	timeout := b.Service.GetTerminationTimeout()
 
 	if b.waitForCompletionWithTimeout(timeout) {
-		return out, nil
+		return nil
 	}
 
 	err = KillGroup(b.cmd.Process.Pid, b.Service)
 	if err != nil {
-		return out, errors.WithMessage(err, "Kill failed")
+		return errors.WithMessage(err, "Kill failed")
 	}
 
 	if b.waitForCompletionWithTimeout(time.Second) {
-		return out, nil
+		return nil
 	}
-	return nil, errors.New("kill did not stop service")
-
-	b.cmd = nil
-	return out, nil
+	return errors.New("kill did not stop service")
 }
 
 // InterruptGroup sends an interrupt signal to a process group.
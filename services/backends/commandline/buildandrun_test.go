@@ -8,6 +8,7 @@ import (
 	"path"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -73,6 +74,55 @@ func TestStartService(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:     "all launch checks",
+			expected: "Hello",
+			service: &services.ServiceConfig{
+				Path: getPath("service"),
+				Backends: []*services.BackendConfig{
+					{
+						Name: "backend1",
+						Type: "commandline",
+						Config: &commandline.Backend{
+							Commands: commandline.ServiceConfigCommands{
+								Launch: "go run main.go",
+							},
+							LaunchChecks: &commandline.LaunchChecks{
+								LogText: "Started",
+								Commands: []*commandline.CommandCheck{
+									{Command: "ls main.go"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:     "any launch check",
+			expected: "Hello",
+			service: &services.ServiceConfig{
+				Path: getPath("service"),
+				Backends: []*services.BackendConfig{
+					{
+						Name: "backend1",
+						Type: "commandline",
+						Config: &commandline.Backend{
+							Commands: commandline.ServiceConfigCommands{
+								Launch: "go run main.go",
+							},
+							LaunchChecks: &commandline.LaunchChecks{
+								LogText: "Started",
+								TCP: []*commandline.TCPCheck{
+									{Address: "127.0.0.1:1"},
+								},
+								Require: commandline.RequireAny,
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -193,6 +243,53 @@ func TestStartServiceFailure(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:     "command launch check",
+			expected: errors.New("process exited"),
+			service: &services.ServiceConfig{
+				Path: getPath("launchfailure"),
+				Backends: []*services.BackendConfig{
+					{
+						Name: "backend1",
+						Type: "commandline",
+						Config: &commandline.Backend{
+							Commands: commandline.ServiceConfigCommands{
+								Launch: "go run main.go",
+							},
+							LaunchChecks: &commandline.LaunchChecks{
+								Commands: []*commandline.CommandCheck{
+									{Command: "false"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:     "launch timeout",
+			expected: errors.New("launch timed out after 2s"),
+			service: &services.ServiceConfig{
+				Path:          getPath("service"),
+				LaunchTimeout: &services.Duration{Duration: 2 * time.Second},
+				Backends: []*services.BackendConfig{
+					{
+						Name: "backend1",
+						Type: "commandline",
+						Config: &commandline.Backend{
+							Commands: commandline.ServiceConfigCommands{
+								Launch: "go run main.go",
+							},
+							LaunchChecks: &commandline.LaunchChecks{
+								TCP: []*commandline.TCPCheck{
+									{Address: "127.0.0.1:1"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -213,3 +310,56 @@ func TestStartServiceFailure(t *testing.T) {
 		})
 	}
 }
+
+func TestLivenessChecks(t *testing.T) {
+	path := path.Join("testdata", "service")
+	service := &services.ServiceConfig{
+		Path: &path,
+		Backends: []*services.BackendConfig{
+			{
+				Name: "backend1",
+				Type: "commandline",
+				Config: &commandline.Backend{
+					Commands: commandline.ServiceConfigCommands{
+						Launch: "go run main.go",
+					},
+					LaunchChecks: &commandline.LaunchChecks{
+						LogText: "Started",
+					},
+					LivenessChecks: &commandline.LivenessChecks{
+						TCP: []*commandline.TCPCheck{
+							{Address: "127.0.0.1:1"},
+						},
+						Period:           &services.Duration{Duration: 100 * time.Millisecond},
+						FailureThreshold: 2,
+					},
+				},
+			},
+		},
+	}
+
+	runner, err := services.GetRunner(services.OperationConfig{}, service)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = runner.Start(os.Stdout, os.Stderr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var stopped = make(chan struct{})
+	go func() {
+		runner.Wait()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(4 * time.Second):
+		t.Fatal("expected service to be stopped by failing liveness checks")
+	}
+
+	exitCode, exited := runner.(services.ExitReporter).ExitCode()
+	if !exited || exitCode == 0 {
+		t.Errorf("expected non-zero exit code, got %d (exited: %v)", exitCode, exited)
+	}
+}
@@ -0,0 +1,190 @@
+package commandline
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"regexp"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/yext/edward/commandline"
+	"github.com/yext/edward/services"
+)
+
+const (
+	// RequireAll indicates that all launch checks must pass for a service to be considered started
+	RequireAll = "all"
+	// RequireAny indicates that a service will be considered started once any launch check passes
+	RequireAny = "any"
+)
+
+const (
+	defaultCheckTimeout  = 5 * time.Second
+	defaultCheckInterval = time.Second
+)
+
+// CheckTiming configures how often a launch check is attempted, and how long each attempt may take.
+type CheckTiming struct {
+	// Maximum time for a single attempt of this check. Defaults to 5s.
+	Timeout *services.Duration `json:"timeout,omitempty"`
+	// Time to wait between attempts of this check. Defaults to 1s.
+	Interval *services.Duration `json:"interval,omitempty"`
+}
+
+// GetTimeout returns the timeout for a single attempt of this check
+func (c CheckTiming) GetTimeout() time.Duration {
+	if c.Timeout == nil {
+		return defaultCheckTimeout
+	}
+	return c.Timeout.Duration
+}
+
+// GetInterval returns the time to wait between attempts of this check
+func (c CheckTiming) GetInterval() time.Duration {
+	if c.Interval == nil {
+		return defaultCheckInterval
+	}
+	return c.Interval.Duration
+}
+
+// HTTPCheck will pass when a GET request to a URL returns an expected response.
+type HTTPCheck struct {
+	// URL to request
+	URL string `json:"url"`
+	// Status codes indicating success. If empty, any 2xx status is accepted.
+	Status []int `json:"status,omitempty"`
+	// Optional regular expression to be matched against the response body
+	Body string `json:"body,omitempty"`
+
+	CheckTiming
+}
+
+func (c *HTTPCheck) validate() error {
+	if c.URL == "" {
+		return errors.New("http launch check requires a url")
+	}
+	if c.Body != "" {
+		if _, err := regexp.Compile(c.Body); err != nil {
+			return errors.Wrap(err, "invalid body pattern for http launch check")
+		}
+	}
+	return nil
+}
+
+func (c *HTTPCheck) String() string {
+	return fmt.Sprintf("http %v", c.URL)
+}
+
+func (c *HTTPCheck) run() (bool, error) {
+	client := &http.Client{
+		Timeout: c.GetTimeout(),
+	}
+	resp, err := client.Get(c.URL)
+	if err != nil {
+		return false, nil
+	}
+	defer resp.Body.Close()
+
+	if !c.statusMatches(resp.StatusCode) {
+		return false, nil
+	}
+	if c.Body == "" {
+		return true, nil
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return false, nil
+	}
+	matched, err := regexp.Match(c.Body, body)
+	return matched, errors.WithStack(err)
+}
+
+func (c *HTTPCheck) statusMatches(status int) bool {
+	if len(c.Status) == 0 {
+		return status >= 200 && status < 300
+	}
+	for _, expected := range c.Status {
+		if status == expected {
+			return true
+		}
+	}
+	return false
+}
+
+// TCPCheck will pass when a TCP connection can be established to an address.
+type TCPCheck struct {
+	// Address to connect to, in the form host:port
+	Address string `json:"address"`
+
+	CheckTiming
+}
+
+func (c *TCPCheck) validate() error {
+	if c.Address == "" {
+		return errors.New("tcp launch check requires an address")
+	}
+	return nil
+}
+
+func (c *TCPCheck) String() string {
+	return fmt.Sprintf("tcp %v", c.Address)
+}
+
+func (c *TCPCheck) run() (bool, error) {
+	conn, err := net.DialTimeout("tcp", c.Address, c.GetTimeout())
+	if err != nil {
+		return false, nil
+	}
+	conn.Close()
+	return true, nil
+}
+
+// CommandCheck will pass when a command exits successfully.
+// The command is run in the service's path.
+type CommandCheck struct {
+	// Command to run
+	Command string `json:"command"`
+
+	CheckTiming
+}
+
+func (c *CommandCheck) validate() error {
+	if c.Command == "" {
+		return errors.New("command launch check requires a command")
+	}
+	return nil
+}
+
+func (c *CommandCheck) String() string {
+	return fmt.Sprintf("command '%v'", c.Command)
+}
+
+func (c *CommandCheck) run(service *services.ServiceConfig) (bool, error) {
+	cmd, err := commandline.ConstructCommand("", service.Path, c.Command, os.Getenv)
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+	err = cmd.Start()
+	if err != nil {
+		return false, nil
+	}
+
+	var result = make(chan error, 1)
+	go func() {
+		result <- cmd.Wait()
+	}()
+
+	timer := time.NewTimer(c.GetTimeout())
+	defer timer.Stop()
+	select {
+	case err := <-result:
+		return err == nil, nil
+	case <-timer.C:
+		_ = cmd.Process.Kill()
+		return false, nil
+	}
+}
@@ -0,0 +1,64 @@
+package commandline
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"strings"
+	"time"
+)
+
+// monitorLiveness runs the configured liveness checks periodically until done is closed.
+// If the checks fail for enough consecutive rounds, the service will be stopped.
+func (b *buildandrun) monitorLiveness(done <-chan struct{}, errorLog io.Writer) {
+	lc := b.Backend.LivenessChecks
+	checks := b.probeChecks(lc.Ports, lc.HTTP, lc.TCP, lc.Commands)
+	if len(checks) == 0 {
+		return
+	}
+
+	ticker := time.NewTicker(lc.GetPeriod())
+	defer ticker.Stop()
+
+	threshold := lc.GetFailureThreshold()
+	var failures int
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+		}
+
+		failed := failedChecks(checks)
+		if len(failed) == 0 {
+			failures = 0
+			continue
+		}
+		failures++
+		log.Printf("Liveness checks failed (%d/%d): %v\n", failures, threshold, strings.Join(failed, ", "))
+		if failures < threshold {
+			continue
+		}
+
+		fmt.Fprintf(errorLog, "liveness checks failed: %v\n", strings.Join(failed, ", "))
+		if err := b.stopGroup(); err != nil {
+			log.Printf("Could not stop service: %v\n", err)
+		}
+		return
+	}
+}
+
+// failedChecks performs a single attempt of each check, returning the names of those that did not pass.
+func failedChecks(checks []launchCheck) []string {
+	var failed []string
+	for _, c := range checks {
+		passed, err := c.check()
+		if err != nil {
+			log.Printf("Liveness check %v failed: %v\n", c.name, err)
+		}
+		if !passed {
+			failed = append(failed, c.name)
+		}
+	}
+	return failed
+}
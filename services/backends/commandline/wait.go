@@ -1,6 +1,10 @@
 package commandline
 
 import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -9,34 +13,186 @@ import (
 	"github.com/yext/edward/instance"
 )
 
+// portCheckInterval is the time between checks for listening ports
+const portCheckInterval = 100 * time.Millisecond
+
+// pendingReportInterval is the time between log messages listing the launch checks still pending
+const pendingReportInterval = 5 * time.Second
+
+// launchCheck is a single condition that is tested repeatedly until it passes
+type launchCheck struct {
+	name     string
+	interval time.Duration
+	check    func() (bool, error)
+}
+
 // waitUntilLive blocks until a command running the specified service is in the RUNNING state.
 // An error will be returned if the command exits before reaching RUNNING.
 func (b *buildandrun) waitUntilLive() error {
+	checks := b.launchChecks()
+	requireAny := b.Backend.LaunchChecks != nil && b.Backend.LaunchChecks.Require == RequireAny
+	return errors.WithStack(
+		waitForChecks(checks, requireAny, b.done),
+	)
+}
+
+// launchChecks returns the set of checks configured for this service.
+// If no checks are configured, the service will be considered live once it opens any port.
+func (b *buildandrun) launchChecks() []launchCheck {
 	pid := int32(b.cmd.Process.Pid)
-	if b.Backend.LaunchChecks != nil && len(b.Backend.LaunchChecks.LogText) > 0 {
-		<-b.launchConditionMet
-		return nil
-	} else if b.Backend.LaunchChecks != nil && len(b.Backend.LaunchChecks.Ports) > 0 {
-		return errors.WithStack(
-			waitForListeningPorts(b.Backend.LaunchChecks.Ports, pid),
-		)
-	} else if b.Backend.LaunchChecks != nil && b.Backend.LaunchChecks.Wait != 0 {
-		delay := time.NewTimer(time.Duration(b.Backend.LaunchChecks.Wait) * time.Millisecond)
-		defer delay.Stop()
+	lc := b.Backend.LaunchChecks
+	if lc == nil {
+		lc = &LaunchChecks{}
+	}
+
+	var checks []launchCheck
+	if len(lc.LogText) > 0 {
+		found := b.launchConditionMet
+		checks = append(checks, launchCheck{
+			name:     fmt.Sprintf("log text '%v'", lc.LogText),
+			interval: portCheckInterval,
+			check: func() (bool, error) {
+				select {
+				case <-found:
+					return true, nil
+				default:
+					return false, nil
+				}
+			},
+		})
+	}
+	if lc.Wait != 0 {
+		started := time.Now()
+		delay := time.Duration(lc.Wait) * time.Millisecond
+		checks = append(checks, launchCheck{
+			name:     fmt.Sprintf("wait %v", delay),
+			interval: portCheckInterval,
+			check: func() (bool, error) {
+				return time.Since(started) >= delay, nil
+			},
+		})
+	}
+	checks = append(checks, b.probeChecks(lc.Ports, lc.HTTP, lc.TCP, lc.Commands)...)
+
+	if len(checks) == 0 {
+		checks = append(checks, launchCheck{
+			name:     "any listening port",
+			interval: portCheckInterval,
+			check: func() (bool, error) {
+				return hasAnyPort(pid)
+			},
+		})
+	}
+	return checks
+}
+
+// probeChecks returns checks for the provided ports, HTTP endpoints, TCP addresses and commands.
+func (b *buildandrun) probeChecks(ports []int, httpChecks []*HTTPCheck, tcpChecks []*TCPCheck, commandChecks []*CommandCheck) []launchCheck {
+	var checks []launchCheck
+	if len(ports) > 0 {
+		checks = append(checks, launchCheck{
+			name:     fmt.Sprintf("ports %v", ports),
+			interval: portCheckInterval,
+			check: func() (bool, error) {
+				return areListeningPortsOpen(ports)
+			},
+		})
+	}
+	for _, httpCheck := range httpChecks {
+		checks = append(checks, launchCheck{
+			name:     httpCheck.String(),
+			interval: httpCheck.GetInterval(),
+			check:    httpCheck.run,
+		})
+	}
+	for _, tcpCheck := range tcpChecks {
+		checks = append(checks, launchCheck{
+			name:     tcpCheck.String(),
+			interval: tcpCheck.GetInterval(),
+			check:    tcpCheck.run,
+		})
+	}
+	for _, commandCheck := range commandChecks {
+		commandCheck := commandCheck
+		checks = append(checks, launchCheck{
+			name:     commandCheck.String(),
+			interval: commandCheck.GetInterval(),
+			check: func() (bool, error) {
+				return commandCheck.run(b.Service)
+			},
+		})
+	}
+	return checks
+}
+
+// waitForChecks runs all the provided checks until they pass.
+// If requireAny is true, this will return as soon as a single check passes.
+// Checks that return an error are logged and retried. Checks still pending are logged periodically.
+// All checks will be abandoned if cancel is closed.
+func waitForChecks(checks []launchCheck, requireAny bool, cancel <-chan struct{}) error {
+	var stop = make(chan struct{})
+	defer close(stop)
+
+	var results = make(chan string, len(checks))
+	var pending = make(map[string]struct{})
+	for _, c := range checks {
+		pending[c.name] = struct{}{}
+		go runCheck(c, results, stop, cancel)
+	}
+
+	report := time.NewTicker(pendingReportInterval)
+	defer report.Stop()
+
+	for len(pending) > 0 {
 		select {
-		case <-delay.C:
-			return nil
+		case name := <-results:
+			log.Printf("Launch check passed: %v\n", name)
+			if requireAny {
+				return nil
+			}
+			delete(pending, name)
+		case <-report.C:
+			log.Printf("Waiting for launch checks: %v\n", pendingNames(pending))
+		case <-cancel:
+			return errors.New("process exited")
 		}
 	}
+	return nil
+}
 
-	return errors.WithStack(
-		waitForAnyPort(pid),
-	)
+func runCheck(c launchCheck, results chan<- string, stop <-chan struct{}, cancel <-chan struct{}) {
+	for {
+		passed, err := c.check()
+		if err != nil {
+			log.Printf("Launch check %v failed: %v\n", c.name, err)
+		}
+		if passed {
+			results <- c.name
+			return
+		}
+		select {
+		case <-stop:
+			return
+		case <-cancel:
+			return
+		case <-time.After(c.interval):
+		}
+	}
+}
+
+func pendingNames(pending map[string]struct{}) string {
+	var names []string
+	for name := range pending {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
 }
 
 const portStatusListen = "LISTEN"
 
 func areAnyListeningPortsOpen(c *instance.Instance, ports []int) (bool, error) {
+
 	var matchedPorts = make(map[int]struct{})
 	for _, port := range ports {
 		matchedPorts[port] = struct{}{}
@@ -56,52 +212,36 @@ func areAnyListeningPortsOpen(c *instance.Instance, ports []int) (bool, error) {
 	return false, nil
 }
 
-func waitForListeningPorts(ports []int, pid int32) error {
-	for true {
-		time.Sleep(100 * time.Millisecond)
-
-		var matchedPorts = make(map[int]struct{})
-
-		connections, err := net.Connections("all")
-		if err != nil {
-			return errors.WithStack(err)
-		}
-		for _, connection := range connections {
-			if connection.Status == portStatusListen {
-				matchedPorts[int(connection.Laddr.Port)] = struct{}{}
-			}
-		}
-		allMatched := true
-		for _, port := range ports {
-			if _, ok := matchedPorts[port]; !ok {
-				allMatched = false
-			}
-		}
-		if allMatched {
-			return nil
+func areListeningPortsOpen(ports []int) (bool, error) {
+	var matchedPorts = make(map[int]struct{})
+	connections, err := net.Connections("all")
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+	for _, connection := range connections {
+		if connection.Status == portStatusListen {
+			matchedPorts[int(connection.Laddr.Port)] = struct{}{}
 		}
 	}
-	return errors.New("exited check loop unexpectedly")
-}
 
-func waitForAnyPort(pid int32) error {
-	for true {
-		time.Sleep(100 * time.Millisecond)
-
-		connections, err := net.Connections("all")
-		if err != nil {
-			return errors.WithStack(err)
+	for _, port := range ports {
+		if _, ok := matchedPorts[port]; !ok {
+			return false, nil
 		}
+	}
+	return true, nil
+}
 
-		proc, err := process.NewProcess(pid)
-		if err != nil {
-			return errors.WithStack(err)
-		}
-		if hasPort(proc, connections) {
-			return nil
-		}
+func hasAnyPort(pid int32) (bool, error) {
+	connections, err := net.Connections("all")
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+	proc, err := process.NewProcess(pid)
+	if err != nil {
+		return false, errors.WithStack(err)
 	}
-	return errors.New("exited check loop unexpectedly")
+	return hasPort(proc, connections), nil
 }
 
 func hasPort(proc *process.Process, connections []net.ConnectionStat) bool {
@@ -0,0 +1,149 @@
+package services
+
+import (
+	"github.com/pkg/errors"
+	"github.com/yext/edward/tracker"
+)
+
+// HasRequirements returns true if any service in the slice of services and groups
+// requires another service.
+func HasRequirements(sgs []ServiceOrGroup) bool {
+	for _, s := range Services(sgs) {
+		if len(s.RequiredServices) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// WithRequirements returns the provided slice of services and groups, preceded by
+// any services they require (directly or indirectly) that are not already included.
+// Required services are returned in the order they need to be started.
+func WithRequirements(sgs []ServiceOrGroup) []ServiceOrGroup {
+	var included = make(map[*ServiceConfig]struct{})
+	for _, s := range Services(sgs) {
+		included[s] = struct{}{}
+	}
+
+	var required []ServiceOrGroup
+	var add func(s *ServiceConfig)
+	add = func(s *ServiceConfig) {
+		for _, r := range s.RequiredServices {
+			if _, exists := included[r]; exists {
+				continue
+			}
+			included[r] = struct{}{}
+			add(r)
+			required = append(required, r)
+		}
+	}
+	for _, s := range Services(sgs) {
+		add(s)
+	}
+	return append(required, sgs...)
+}
+
+// DoForServicesInLevels performs a task for a set of services in the same manner as DoForServices,
+// but orders the services by their requirements.
+//
+// Services are grouped into levels, such that each service only requires services in earlier levels.
+// Within a level, services are visited in the order they would be by DoForServices.
+// If reverse is true, the levels are visited last to first, so services are visited before those they require.
+//
+// levelComplete is called after all services in a level have been visited, so that the caller
+// can wait for any work started for that level to finish before moving on to the next.
+func DoForServicesInLevels(
+	sgs []ServiceOrGroup,
+	task tracker.Task,
+	reverse bool,
+	f func(service *ServiceConfig, overrides ContextOverride, task tracker.Task) error,
+	levelComplete func() error,
+) error {
+	type operation struct {
+		service   *ServiceConfig
+		overrides ContextOverride
+		task      tracker.Task
+	}
+	var operations []operation
+	err := DoForServices(sgs, task, func(service *ServiceConfig, overrides ContextOverride, task tracker.Task) error {
+		operations = append(operations, operation{
+			service:   service,
+			overrides: overrides,
+			task:      task,
+		})
+		return nil
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	var serviceList []*ServiceConfig
+	for _, op := range operations {
+		serviceList = append(serviceList, op.service)
+	}
+	levels := requirementLevels(serviceList)
+
+	var maxLevel int
+	for _, level := range levels {
+		if level > maxLevel {
+			maxLevel = level
+		}
+	}
+
+	for i := 0; i <= maxLevel; i++ {
+		current := i
+		if reverse {
+			current = maxLevel - i
+		}
+		var visited bool
+		for _, op := range operations {
+			if levels[op.service] != current {
+				continue
+			}
+			visited = true
+			err := f(op.service, op.overrides, op.task)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+		}
+		if visited && levelComplete != nil {
+			err := levelComplete()
+			if err != nil {
+				return errors.WithStack(err)
+			}
+		}
+	}
+	return nil
+}
+
+// requirementLevels assigns a level to each of the provided services.
+// Services with no requirements are at level 0, all other services are one level
+// above the highest level of the services they require.
+func requirementLevels(serviceList []*ServiceConfig) map[*ServiceConfig]int {
+	var levels = make(map[*ServiceConfig]int)
+	var visiting = make(map[*ServiceConfig]struct{})
+	var levelFor func(s *ServiceConfig) int
+	levelFor = func(s *ServiceConfig) int {
+		if level, ok := levels[s]; ok {
+			return level
+		}
+		// Guard against cycles in configs that were not validated on load
+		if _, ok := visiting[s]; ok {
+			return 0
+		}
+		visiting[s] = struct{}{}
+		var level int
+		for _, r := range s.RequiredServices {
+			if l := levelFor(r) + 1; l > level {
+				level = l
+			}
+		}
+		delete(visiting, s)
+		levels[s] = level
+		return level
+	}
+	for _, s := range serviceList {
+		levelFor(s)
+	}
+	return levels
+}
@@ -0,0 +1,81 @@
+package services_test
+
+import (
+	"testing"
+
+	"github.com/theothertomelliott/must"
+	"github.com/yext/edward/services"
+	"github.com/yext/edward/tracker"
+)
+
+func TestDoForServicesInLevels(t *testing.T) {
+	database := &services.ServiceConfig{Name: "database"}
+	queue := &services.ServiceConfig{
+		Name:             "queue",
+		RequiredServices: []*services.ServiceConfig{database},
+	}
+	api := &services.ServiceConfig{
+		Name:             "api",
+		RequiredServices: []*services.ServiceConfig{queue},
+	}
+	web := &services.ServiceConfig{Name: "web"}
+	group := &services.ServiceGroupConfig{
+		Name:       "group",
+		Services:   []*services.ServiceConfig{api, web},
+		ChildOrder: []string{"api", "web"},
+	}
+
+	var tests = []struct {
+		name     string
+		sgs      []services.ServiceOrGroup
+		reverse  bool
+		expected []string
+	}{
+		{
+			name:     "no requirements",
+			sgs:      []services.ServiceOrGroup{web, database},
+			expected: []string{"web", "database", "|"},
+		},
+		{
+			name:     "requirements added",
+			sgs:      services.WithRequirements([]services.ServiceOrGroup{api}),
+			expected: []string{"database", "|", "queue", "|", "api", "|"},
+		},
+		{
+			name:     "requirements in group",
+			sgs:      services.WithRequirements([]services.ServiceOrGroup{group}),
+			expected: []string{"database", "web", "|", "queue", "|", "api", "|"},
+		},
+		{
+			name:     "reversed",
+			sgs:      []services.ServiceOrGroup{database, group, queue},
+			reverse:  true,
+			expected: []string{"api", "|", "queue", "|", "database", "web", "|"},
+		},
+		{
+			name:     "indirect requirement not included",
+			sgs:      []services.ServiceOrGroup{api, database},
+			expected: []string{"database", "|", "api", "|"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var got []string
+			err := services.DoForServicesInLevels(
+				test.sgs,
+				tracker.NewTask(nil),
+				test.reverse,
+				func(service *services.ServiceConfig, overrides services.ContextOverride, task tracker.Task) error {
+					got = append(got, service.Name)
+					return nil
+				},
+				func() error {
+					got = append(got, "|")
+					return nil
+				},
+			)
+			must.BeNoError(t, err)
+			must.BeEqual(t, test.expected, got)
+		})
+	}
+}
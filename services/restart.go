@@ -0,0 +1,80 @@
+package services
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// RestartNever indicates that a service should not be restarted when it exits
+	RestartNever = "never"
+	// RestartOnFailure indicates that a service should be restarted if it exits with a non-zero exit code
+	RestartOnFailure = "on-failure"
+	// RestartAlways indicates that a service should be restarted whenever it exits
+	RestartAlways = "always"
+)
+
+const (
+	defaultRestartBackoff    = time.Second
+	defaultRestartMaxBackoff = time.Minute
+)
+
+// RestartPolicy defines how a service should be handled if it exits unexpectedly
+type RestartPolicy struct {
+	// When to restart the service: "never" (the default), "on-failure" or "always"
+	Policy string `json:"policy,omitempty"`
+	// Maximum number of times the service will be restarted. If zero, there is no limit.
+	MaxRetries int `json:"maxRetries,omitempty"`
+	// Delay before the first restart, doubled for each subsequent restart. Defaults to 1s.
+	Backoff *Duration `json:"backoff,omitempty"`
+	// Maximum delay between restarts. Defaults to 1m.
+	MaxBackoff *Duration `json:"maxBackoff,omitempty"`
+}
+
+func (r *RestartPolicy) validate() error {
+	switch r.Policy {
+	case "", RestartNever, RestartOnFailure, RestartAlways:
+		return nil
+	default:
+		return errors.Errorf("unknown restart policy %q", r.Policy)
+	}
+}
+
+// ShouldRestart returns true if a service that exited with the given exit code
+// should be restarted, given the number of times it has already been restarted.
+func (r *RestartPolicy) ShouldRestart(exitCode int, restarts int) bool {
+	if r == nil {
+		return false
+	}
+	if r.MaxRetries > 0 && restarts >= r.MaxRetries {
+		return false
+	}
+	switch r.Policy {
+	case RestartAlways:
+		return true
+	case RestartOnFailure:
+		return exitCode != 0
+	default:
+		return false
+	}
+}
+
+// GetBackoff returns the time to wait before restarting a service that has already been
+// restarted the given number of times.
+func (r *RestartPolicy) GetBackoff(restarts int) time.Duration {
+	backoff, maxBackoff := defaultRestartBackoff, defaultRestartMaxBackoff
+	if r != nil && r.Backoff != nil {
+		backoff = r.Backoff.Duration
+	}
+	if r != nil && r.MaxBackoff != nil {
+		maxBackoff = r.MaxBackoff.Duration
+	}
+	for i := 0; i < restarts && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxBackoff {
+		return maxBackoff
+	}
+	return backoff
+}
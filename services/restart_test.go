@@ -0,0 +1,83 @@
+package services_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/theothertomelliott/must"
+	"github.com/yext/edward/services"
+)
+
+func TestRestartPolicy(t *testing.T) {
+	var tests = []struct {
+		name            string
+		policy          *services.RestartPolicy
+		exitCode        int
+		restarts        int
+		expectedRestart bool
+		expectedBackoff time.Duration
+	}{
+		{
+			name:            "no policy",
+			exitCode:        1,
+			expectedRestart: false,
+			expectedBackoff: time.Second,
+		},
+		{
+			name:            "never",
+			policy:          &services.RestartPolicy{Policy: services.RestartNever},
+			exitCode:        1,
+			expectedRestart: false,
+			expectedBackoff: time.Second,
+		},
+		{
+			name:            "on failure with failure",
+			policy:          &services.RestartPolicy{Policy: services.RestartOnFailure},
+			exitCode:        1,
+			restarts:        2,
+			expectedRestart: true,
+			expectedBackoff: 4 * time.Second,
+		},
+		{
+			name:            "on failure with success",
+			policy:          &services.RestartPolicy{Policy: services.RestartOnFailure},
+			exitCode:        0,
+			expectedRestart: false,
+			expectedBackoff: time.Second,
+		},
+		{
+			name:            "always with success",
+			policy:          &services.RestartPolicy{Policy: services.RestartAlways},
+			exitCode:        0,
+			expectedRestart: true,
+			expectedBackoff: time.Second,
+		},
+		{
+			name: "max retries reached",
+			policy: &services.RestartPolicy{
+				Policy:     services.RestartAlways,
+				MaxRetries: 3,
+			},
+			restarts:        3,
+			expectedRestart: false,
+			expectedBackoff: 8 * time.Second,
+		},
+		{
+			name: "max backoff",
+			policy: &services.RestartPolicy{
+				Policy:     services.RestartAlways,
+				Backoff:    &services.Duration{Duration: 10 * time.Second},
+				MaxBackoff: &services.Duration{Duration: 30 * time.Second},
+			},
+			restarts:        5,
+			expectedRestart: true,
+			expectedBackoff: 30 * time.Second,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			must.BeEqual(t, test.expectedRestart, test.policy.ShouldRestart(test.exitCode, test.restarts))
+			must.BeEqual(t, test.expectedBackoff, test.policy.GetBackoff(test.restarts))
+		})
+	}
+}
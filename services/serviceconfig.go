@@ -31,6 +31,12 @@ type ServiceConfig struct {
 	// Does this service require sudo privileges?
 	RequiresSudo bool `json:"requiresSudo,omitempty"`
 
+	// Names of services that must be running before this service is launched.
+	// Required services will be started along with this service, and stopped after it.
+	Requires []string `json:"requires,omitempty"`
+	// Services named in Requires, resolved when the config is loaded
+	RequiredServices []*ServiceConfig `json:"-"`
+
 	// Env holds environment variables for a service, for example: GOPATH=~/gocode/
 	// These will be added to the vars in the environment under which the Edward command was run
 	Env []string `json:"env,omitempty"`
@@ -52,6 +58,13 @@ type ServiceConfig struct {
 	// Timeout for terminating a service runner. If termination has not completed after this amount
 	// of time, the runner will be killed.
 	TerminationTimeout *Duration `json:"terminationTimeout,omitempty"`
+
+	// Timeout for launching a service. If the service has not passed its launch checks after
+	// this amount of time, the launch will fail and the service will be stopped.
+	LaunchTimeout *Duration `json:"launchTimeout,omitempty"`
+
+	// Policy for restarting this service if it exits after a successful launch
+	Restart *RestartPolicy `json:"restart,omitempty"`
 }
 
 // GetTerminationTimeout returns the timeout for termination, if no timeout is set, the
@@ -63,6 +76,15 @@ func (c *ServiceConfig) GetTerminationTimeout() time.Duration {
 	return c.TerminationTimeout.Duration
 }
 
+// GetLaunchTimeout returns the timeout for launching, if no timeout is set, the
+// default of 5m will be returned
+func (c *ServiceConfig) GetLaunchTimeout() time.Duration {
+	if c.LaunchTimeout == nil {
+		return 5 * time.Minute
+	}
+	return c.LaunchTimeout.Duration
+}
+
 // Backend returns the default backend for this service
 func (c *ServiceConfig) Backend() Backend {
 	for _, backendConfig := range c.Backends {
@@ -171,6 +193,12 @@ func (c *ServiceConfig) UnmarshalJSON(data []byte) error {
 		return errors.New("no backends specified in config")
 	}
 
+	if c.Restart != nil {
+		if err := c.Restart.validate(); err != nil {
+			return errors.Wrap(err, "could not parse service config")
+		}
+	}
+
 	return nil
 }
 
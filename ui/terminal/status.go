@@ -16,8 +16,14 @@ func (p *Provider) Status(statuses []ui.ServiceStatus) {
 	var (
 		configByService []string
 		configSet       = make(map[string]struct{})
+		showRestarts    bool
 	)
 	for _, serviceStatus := range statuses {
+		status := serviceStatus.Status()
+		if status.RestartCount > 0 || status.LastExitCode != nil || serviceStatus.Service().Restart != nil {
+			showRestarts = true
+		}
+
 		configPath := serviceStatus.Service().ConfigFile
 		wd, err := os.Getwd()
 		if err == nil {
@@ -43,6 +49,9 @@ func (p *Provider) Status(statuses []ui.ServiceStatus) {
 		"Swap",
 		"Start Time",
 	}
+	if showRestarts {
+		headings = append(headings, "Restarts", "Last Exit")
+	}
 	if len(configSet) > 1 {
 		headings = append(headings, "Config File")
 	}
@@ -72,6 +81,13 @@ func (p *Provider) Status(statuses []ui.ServiceStatus) {
 			status.StartTime.Format("2006-01-02 15:04:05"),
 		}
 
+		if showRestarts {
+			lastExit := ""
+			if status.LastExitCode != nil {
+				lastExit = strconv.Itoa(*status.LastExitCode)
+			}
+			row = append(row, strconv.Itoa(status.RestartCount), lastExit)
+		}
 		if len(configSet) > 1 {
 			row = append(row, configByService[index])
 		}
@@ -13,6 +13,8 @@ type Pool struct {
 	completion chan struct{}
 	workers    int
 
+	pending sync.WaitGroup
+
 	err error
 	mtx sync.Mutex
 }
@@ -68,10 +70,17 @@ func (p *Pool) Enqueue(job func() error) error {
 		return errors.WithStack(job())
 	}
 
+	p.pending.Add(1)
 	p.jobs <- job
 	return nil
 }
 
+// Wait blocks until all jobs enqueued so far have completed.
+// The pool will continue to accept new jobs.
+func (p *Pool) Wait() {
+	p.pending.Wait()
+}
+
 // Stop prevents this pool from accepting new jobs.
 func (p *Pool) Stop() {
 	close(p.jobs)
@@ -88,6 +97,7 @@ func (p *Pool) worker(index int) {
 		if err != nil {
 			p.setErr(err)
 		}
+		p.pending.Done()
 	}
 	p.finished <- struct{}{}
 }
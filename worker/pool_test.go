@@ -1,6 +1,9 @@
 package worker
 
-import "testing"
+import (
+	"testing"
+	"time"
+)
 
 func TestPool(t *testing.T) {
 
@@ -21,3 +24,29 @@ func TestPool(t *testing.T) {
 	}
 
 }
+
+func TestPoolWait(t *testing.T) {
+	pool := NewPool(2)
+	pool.Start()
+
+	var first, second int
+	for i := 0; i < 3; i++ {
+		_ = pool.Enqueue(func() error {
+			time.Sleep(10 * time.Millisecond)
+			first++
+			return nil
+		})
+		pool.Wait()
+	}
+	_ = pool.Enqueue(func() error {
+		second = first
+		return nil
+	})
+
+	pool.Stop()
+	_ = <-pool.Complete()
+
+	if second != 3 {
+		t.Errorf("Expected all jobs to complete before waiting ended, got %v\n", second)
+	}
+}